@@ -0,0 +1,82 @@
+package duration
+
+// Add returns the field-wise sum of d and other.
+func (d Duration) Add(other Duration) Duration {
+	return Duration{
+		Y:  d.Y + other.Y,
+		M:  d.M + other.M,
+		W:  d.W + other.W,
+		D:  d.D + other.D,
+		TH: d.TH + other.TH,
+		TM: d.TM + other.TM,
+		TS: d.TS + other.TS,
+	}
+}
+
+// Neg returns d with every field negated.
+func (d Duration) Neg() Duration {
+	return negate(d)
+}
+
+// Mul returns d with every field multiplied by n.
+func (d Duration) Mul(n int) Duration {
+	return Duration{
+		Y:  d.Y * n,
+		M:  d.M * n,
+		W:  d.W * n,
+		D:  d.D * n,
+		TH: d.TH * n,
+		TM: d.TM * n,
+		TS: d.TS * n,
+	}
+}
+
+// IsZero reports whether every field of d is zero.
+func (d Duration) IsZero() bool {
+	return d == (Duration{})
+}
+
+// Normalize collapses each field into the next-larger one wherever the
+// conversion is unambiguous (60 seconds into a minute, 60 minutes into an
+// hour, 24 hours into a day, 7 days into a week, 12 months into a year),
+// leaving ambiguous conversions such as days into months untouched. Fields
+// of mixed sign (as Add and Neg can easily produce, e.g. a.Add(b.Neg()))
+// are reconciled by borrowing from the next-larger unit, so that two
+// Durations representing the same span always normalize to the same
+// fields regardless of how that span was assembled.
+func (d Duration) Normalize() Duration {
+	// Weeks through seconds convert at fixed factors, so fold them into one
+	// signed total and split it back out; every field then shares its sign.
+	secs := (((d.W*7+d.D)*24+d.TH)*60+d.TM)*60 + d.TS
+	d.TS, secs = secs%60, secs/60
+	d.TM, secs = secs%60, secs/60
+	d.TH, secs = secs%24, secs/24
+	d.W, d.D = secs/7, secs%7
+	d.Y, d.M = carry(d.Y, d.M, 12)
+
+	return d
+}
+
+// carry folds small's overflow into large using the given factor, then
+// borrows one unit back from large if the remaining small disagrees in
+// sign with it, so the pair ends up with a consistent sign.
+func carry(large, small, factor int) (int, int) {
+	large += small / factor
+	small %= factor
+
+	if small > 0 && large < 0 {
+		large++
+		small -= factor
+	} else if small < 0 && large > 0 {
+		large--
+		small += factor
+	}
+
+	return large, small
+}
+
+// Equal reports whether d and other represent the same duration once both
+// have been normalized.
+func (d Duration) Equal(other Duration) bool {
+	return d.Normalize() == other.Normalize()
+}
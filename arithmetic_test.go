@@ -0,0 +1,84 @@
+package duration
+
+import "testing"
+
+func TestCanAddDurations(t *testing.T) {
+	a := Duration{Y: 1, D: 2, TH: 3}
+	b := Duration{Y: 1, M: 1, TH: 1, TS: 30}
+
+	want := Duration{Y: 2, M: 1, D: 2, TH: 4, TS: 30}
+	got := a.Add(b)
+	if want != got {
+		t.Fatalf("want=%+v, got=%+v", want, got)
+	}
+}
+
+func TestCanNegateDuration(t *testing.T) {
+	d := Duration{Y: 1, M: -2, D: 3}
+	want := Duration{Y: -1, M: 2, D: -3}
+	got := d.Neg()
+	if want != got {
+		t.Fatalf("want=%+v, got=%+v", want, got)
+	}
+}
+
+func TestCanMultiplyDuration(t *testing.T) {
+	d := Duration{D: 1, TH: 2}
+	want := Duration{D: 3, TH: 6}
+	got := d.Mul(3)
+	if want != got {
+		t.Fatalf("want=%+v, got=%+v", want, got)
+	}
+}
+
+func TestCanDetectZeroDuration(t *testing.T) {
+	if !(Duration{}).IsZero() {
+		t.Fatal("want zero Duration to report IsZero")
+	}
+	if (Duration{D: 1}).IsZero() {
+		t.Fatal("want non-zero Duration to not report IsZero")
+	}
+}
+
+func TestCanNormalizeDuration(t *testing.T) {
+	cases := []struct {
+		from Duration
+		want Duration
+	}{
+		{Duration{TH: 25}, Duration{D: 1, TH: 1}},
+		{Duration{M: 12}, Duration{Y: 1}},
+		{Duration{TS: 90}, Duration{TM: 1, TS: 30}},
+		{Duration{D: 8}, Duration{W: 1, D: 1}},
+		{Duration{D: 2, TH: -1}, Duration{D: 1, TH: 23}},
+		{Duration{TH: -47}, Duration{D: -1, TH: -23}},
+		{Duration{D: -2, TH: 1}, Duration{D: -1, TH: -23}},
+	}
+
+	for k, c := range cases {
+		got := c.from.Normalize()
+		if c.want != got {
+			t.Fatalf("Case %d: want=%+v, got=%+v", k, c.want, got)
+		}
+	}
+}
+
+func TestCanCompareEquivalentDurations(t *testing.T) {
+	a := Duration{TH: 25}
+	b := Duration{D: 1, TH: 1}
+
+	if !a.Equal(b) {
+		t.Fatalf("want %+v to equal %+v", a, b)
+	}
+	if a == b {
+		t.Fatal("want representations to differ before normalization")
+	}
+}
+
+func TestCanCompareMixedSignEquivalentDurations(t *testing.T) {
+	a := Duration{D: 2, TH: -1}
+	b := Duration{TH: 47}
+
+	if !a.Equal(b) {
+		t.Fatalf("want %+v to equal %+v", a, b)
+	}
+}
@@ -0,0 +1,92 @@
+package duration
+
+import "time"
+
+// Between computes the calendar Duration between two points in time, such
+// that Between(from, to).Shift(from) equals to. Years, then months, then
+// days are resolved first, borrowing from the next-larger unit whenever the
+// target day-of-month would otherwise go negative (e.g. a source date of
+// Jan 31 borrows from the month field when landing in a shorter month).
+// The remaining hours, minutes and seconds are taken from what is left of
+// the interval once the calendar portion has been applied.
+func Between(from, to time.Time) Duration {
+	if to.Equal(from) {
+		return Duration{}
+	}
+	if to.Before(from) {
+		return negate(Between(to, from))
+	}
+
+	loc := from.Location()
+	to = to.In(loc)
+
+	y := to.Year() - from.Year()
+	m := int(to.Month()) - int(from.Month())
+	d := to.Day() - from.Day()
+
+	if d < 0 {
+		m--
+		// The last day of the month before `to`'s month.
+		prevMonthEnd := time.Date(to.Year(), to.Month(), 0, 0, 0, 0, 0, loc)
+		d += prevMonthEnd.Day()
+	}
+	if m < 0 {
+		y--
+		m += 12
+	}
+
+	// Shift applies Y/M/D in a single calendar step, which can overflow into
+	// the following month for dates near month end (e.g. Jan 31 plus one
+	// month). Correct Y/M/D so that re-applying them to `from` reproduces
+	// `to` exactly. Months are given back first while Y/M alone overshoots,
+	// so that D never has to go negative.
+	for (Duration{Y: y, M: m}).Shift(from).After(to) {
+		if m == 0 {
+			y--
+			m = 12
+		}
+		m--
+	}
+	if d < 0 {
+		d = 0
+	}
+	for (Duration{Y: y, M: m, D: d}).Shift(from).After(to) {
+		d--
+	}
+	for !(Duration{Y: y, M: m, D: d + 1}).Shift(from).After(to) {
+		d++
+	}
+
+	mid := Duration{Y: y, M: m, D: d}.Shift(from)
+	rem := to.Sub(mid)
+
+	th := int(rem / time.Hour)
+	rem -= time.Duration(th) * time.Hour
+	tm := int(rem / time.Minute)
+	rem -= time.Duration(tm) * time.Minute
+	ts := int(rem / time.Second)
+
+	return Duration{Y: y, M: m, D: d, TH: th, TM: tm, TS: ts}
+}
+
+// Sub returns t shifted backward by the duration, taking off the clock part
+// and then the calendar part, the reverse of Shift's order. d.Sub(d.Shift(t))
+// equals t unless the calendar part of Shift overflows a month end: one month
+// from Jan 31, 2019 is Mar 3, and one month back from Mar 3 is Feb 3.
+func (d Duration) Sub(t time.Time) time.Time {
+	t = t.Add(-(time.Duration(d.TH)*time.Hour + time.Duration(d.TM)*time.Minute + time.Duration(d.TS)*time.Second))
+	return t.AddDate(-d.Y, -d.M, -(d.W*7 + d.D))
+}
+
+// negate flips the sign of every field of d.
+func negate(d Duration) Duration {
+	return Duration{
+		Y:  -d.Y,
+		M:  -d.M,
+		W:  -d.W,
+		D:  -d.D,
+		TH: -d.TH,
+		TM: -d.TM,
+		TS: -d.TS,
+	}
+}
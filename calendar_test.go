@@ -0,0 +1,70 @@
+package duration
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBetweenIsInverseOfShift(t *testing.T) {
+	cases := []struct {
+		from string
+		to   string
+	}{
+		{"Jan 1, 2018 at 00:00:00", "Jan 1, 2018 at 00:00:00"},
+		{"Jan 1, 2018 at 00:00:00", "Jun 9, 2028 at 05:10:06"},
+		{"Jan 31, 2019 at 12:00:00", "Mar 1, 2019 at 06:30:00"},
+		{"Feb 29, 2020 at 00:00:00", "Mar 1, 2021 at 00:00:00"},
+	}
+
+	for k, c := range cases {
+		from := makeTime(t, c.from)
+		to := makeTime(t, c.to)
+
+		got := Between(from, to).Shift(from)
+		if !to.Equal(got) {
+			t.Fatalf("Case %d: want=%s, got=%s", k, to, got)
+		}
+	}
+}
+
+func TestBetweenReversed(t *testing.T) {
+	from := makeTime(t, "Jan 1, 2018 at 00:00:00")
+	to := makeTime(t, "Mar 1, 2018 at 00:00:00")
+
+	forward := Between(from, to)
+	backward := Between(to, from)
+
+	if got := backward.Shift(to); !from.Equal(got) {
+		t.Fatalf("want=%s, got=%s", from, got)
+	}
+	if forward == backward {
+		t.Fatalf("expected forward and backward durations to differ, both were %+v", forward)
+	}
+}
+
+func TestBetweenAcrossDST(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	from := time.Date(2018, time.March, 10, 0, 0, 0, 0, loc)
+	to := time.Date(2018, time.March, 12, 0, 0, 0, 0, loc)
+
+	got := Between(from, to).Shift(from)
+	if !to.Equal(got) {
+		t.Fatalf("want=%s, got=%s", to, got)
+	}
+}
+
+func TestDurationSubIsInverseOfShift(t *testing.T) {
+	from := makeTime(t, "Jan 1, 2018 at 00:00:00")
+	d := Duration{Y: 1, M: 2, D: 3, TH: 4, TM: 5, TS: 6}
+
+	shifted := d.Shift(from)
+	got := d.Sub(shifted)
+
+	if !from.Equal(got) {
+		t.Fatalf("want=%s, got=%s", from, got)
+	}
+}
@@ -0,0 +1,177 @@
+// Package duration implements ISO-8601 durations, including parsing,
+// string formatting and applying a duration to a time.Time.
+package duration
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Duration represents an ISO-8601 duration, made up of a calendar part
+// (years, months, weeks, days) and a time part (hours, minutes, seconds).
+type Duration struct {
+	Y  int
+	M  int
+	W  int
+	D  int
+	TH int
+	TM int
+	TS int
+}
+
+var iso8601Pattern = regexp.MustCompile(`^P(?:(-?\d+)Y)?(?:(-?\d+)M)?(?:(-?\d+)W)?(?:(-?\d+)D)?(?:T(?:(-?\d+)H)?(?:(-?\d+)M)?(?:(-?\d+)S)?)?$`)
+
+// iso8601PrefixPattern is iso8601Pattern without the trailing "$", used only
+// to find how far a malformed duration parsed before diverging.
+var iso8601PrefixPattern = regexp.MustCompile(`^P(?:(-?\d+)Y)?(?:(-?\d+)M)?(?:(-?\d+)W)?(?:(-?\d+)D)?(?:T(?:(-?\d+)H)?(?:(-?\d+)M)?(?:(-?\d+)S)?)?`)
+
+// ParseISO8601 parses an ISO-8601 duration string, such as "P1Y2M3DT4H5M6S",
+// into a Duration. On failure it returns a *ParseError.
+func ParseISO8601(s string) (Duration, error) {
+	if s == "" {
+		return Duration{}, &ParseError{Input: s, Pos: 0, Reason: "empty duration"}
+	}
+
+	matches := iso8601Pattern.FindStringSubmatch(s)
+	if matches == nil {
+		return Duration{}, &ParseError{Input: s, Pos: iso8601InvalidPos(s), Reason: "invalid ISO-8601 duration"}
+	}
+
+	// Reject strings that only matched the leading "P" with nothing else,
+	// e.g. a plain "P".
+	allEmpty := true
+	for _, m := range matches[1:] {
+		if m != "" {
+			allEmpty = false
+			break
+		}
+	}
+	if allEmpty {
+		return Duration{}, &ParseError{Input: s, Pos: 1, Reason: "duration has no components"}
+	}
+
+	fields := make([]int, len(matches)-1)
+	for i, m := range matches[1:] {
+		if m == "" {
+			continue
+		}
+		n, err := strconv.Atoi(m)
+		if err != nil {
+			return Duration{}, &ParseError{Input: s, Pos: iso8601InvalidPos(s), Reason: fmt.Sprintf("invalid component %q", m)}
+		}
+		fields[i] = n
+	}
+
+	return Duration{
+		Y:  fields[0],
+		M:  fields[1],
+		W:  fields[2],
+		D:  fields[3],
+		TH: fields[4],
+		TM: fields[5],
+		TS: fields[6],
+	}, nil
+}
+
+// iso8601InvalidPos returns the byte offset at which s stops matching the
+// ISO-8601 duration grammar, for use in a ParseError.
+func iso8601InvalidPos(s string) int {
+	m := iso8601PrefixPattern.FindStringIndex(s)
+	if m == nil {
+		return 0
+	}
+	return m[1]
+}
+
+// Shift applies the duration to t and returns the resulting time. Calendar
+// fields (years, months, weeks, days) are applied using calendar arithmetic
+// so that, for example, adding one day keeps the same wall-clock hour across
+// a daylight-saving-time transition.
+func (d Duration) Shift(t time.Time) time.Time {
+	t = t.AddDate(d.Y, d.M, d.W*7+d.D)
+	t = t.Add(time.Duration(d.TH)*time.Hour + time.Duration(d.TM)*time.Minute + time.Duration(d.TS)*time.Second)
+	return t
+}
+
+// String returns the ISO-8601 representation of the duration, omitting any
+// zero-valued components. The zero Duration is rendered as "P0D".
+func (d Duration) String() string {
+	if d == (Duration{}) {
+		return "P0D"
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('P')
+	writeComponent(&buf, d.Y, 'Y')
+	writeComponent(&buf, d.M, 'M')
+	writeComponent(&buf, d.W, 'W')
+	writeComponent(&buf, d.D, 'D')
+
+	if d.TH != 0 || d.TM != 0 || d.TS != 0 {
+		buf.WriteByte('T')
+		writeComponent(&buf, d.TH, 'H')
+		writeComponent(&buf, d.TM, 'M')
+		writeComponent(&buf, d.TS, 'S')
+	}
+
+	return buf.String()
+}
+
+func writeComponent(buf *bytes.Buffer, n int, designator byte) {
+	if n == 0 {
+		return
+	}
+	buf.WriteString(strconv.Itoa(n))
+	buf.WriteByte(designator)
+}
+
+// MarshalJSON implements json.Marshaler, encoding the duration as its
+// ISO-8601 string representation.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(d.String())), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding an ISO-8601 duration
+// string.
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return fmt.Errorf("duration: invalid JSON duration %q", b)
+	}
+
+	parsed, err := ParseISO8601(s)
+	if err != nil {
+		return err
+	}
+
+	*d = parsed
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the duration as its
+// ISO-8601 string representation.
+func (d Duration) MarshalYAML() (interface{}, error) {
+	return d.String(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, decoding an ISO-8601 duration
+// string.
+func (d *Duration) UnmarshalYAML(value *yaml.Node) error {
+	var s string
+	if err := value.Decode(&s); err != nil {
+		return err
+	}
+
+	parsed, err := ParseISO8601(s)
+	if err != nil {
+		return err
+	}
+
+	*d = parsed
+	return nil
+}
@@ -0,0 +1,48 @@
+package duration
+
+import (
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestCanMarshalYAML(t *testing.T) {
+	s := "P1Y2M3W4DT5H6M7S"
+	sut, _ := ParseISO8601(s)
+
+	b, err := yaml.Marshal(sut)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "P1Y2M3W4DT5H6M7S\n"
+	got := string(b)
+	if got != want {
+		t.Fatalf("want=%s, got=%s", want, got)
+	}
+}
+
+func TestCanUnmarshalYAML(t *testing.T) {
+	y := []byte(`P1Y2M3W4DT5H6M7S`)
+	var got Duration
+	err := yaml.Unmarshal(y, &got)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := "P1Y2M3W4DT5H6M7S"
+	want, _ := ParseISO8601(s)
+
+	if got != want {
+		t.Fatalf("want=%+v, got=%+v", want, got)
+	}
+}
+
+func TestCanRejectDurationInYAML(t *testing.T) {
+	y := []byte(`PZY`)
+	var got Duration
+	err := yaml.Unmarshal(y, &got)
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
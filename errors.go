@@ -0,0 +1,15 @@
+package duration
+
+import "fmt"
+
+// ParseError describes why a duration string failed to parse. Pos is the
+// byte offset into Input at which parsing stopped making progress.
+type ParseError struct {
+	Input  string
+	Pos    int
+	Reason string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("duration: %s (input=%q, pos=%d)", e.Reason, e.Input, e.Pos)
+}
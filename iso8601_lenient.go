@@ -0,0 +1,115 @@
+package duration
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var lenientPattern = regexp.MustCompile(`^P(?:(-?\d+)Y)?(?:(-?\d+)M)?(?:(-?\d+)W)?(?:(-?\d+)D)?(?:T(?:(-?\d+(?:\.\d+)?)H)?(?:(-?\d+(?:\.\d+)?)M)?(?:(-?\d+(?:\.\d+)?)S)?)?$`)
+
+// ParseISO8601Lenient parses s as an ISO-8601 duration, tolerating common
+// real-world deviations from the strict grammar accepted by ParseISO8601:
+// lowercase designators ("p1y2m"), a missing leading "P" when the remainder
+// is otherwise unambiguous, a comma as the decimal separator, and a
+// fractional value on the smallest present time component (e.g. "PT1.5S").
+// A fractional time component distributes into the smaller fields it
+// represents, e.g. "PT1.5H" parses as TH:1, TM:30.
+// On failure it returns a *ParseError whose Pos is 1 when nothing follows
+// the "P" and 0 otherwise; it does not locate where the input diverged.
+func ParseISO8601Lenient(s string) (Duration, error) {
+	normalized := strings.ToUpper(strings.ReplaceAll(s, ",", "."))
+	if normalized == "" {
+		return Duration{}, &ParseError{Input: s, Pos: 0, Reason: "empty duration"}
+	}
+	if normalized[0] != 'P' && (normalized[0] == '-' || (normalized[0] >= '0' && normalized[0] <= '9')) {
+		normalized = "P" + normalized
+	}
+
+	matches := lenientPattern.FindStringSubmatch(normalized)
+	if matches == nil {
+		return Duration{}, &ParseError{Input: s, Pos: 0, Reason: "invalid ISO-8601 duration"}
+	}
+
+	allEmpty := true
+	for _, m := range matches[1:] {
+		if m != "" {
+			allEmpty = false
+			break
+		}
+	}
+	if allEmpty {
+		return Duration{}, &ParseError{Input: s, Pos: 1, Reason: "duration has no components"}
+	}
+
+	var d Duration
+	var err error
+
+	if d.Y, err = atoiField(matches[1]); err != nil {
+		return Duration{}, &ParseError{Input: s, Pos: 0, Reason: err.Error()}
+	}
+	if d.M, err = atoiField(matches[2]); err != nil {
+		return Duration{}, &ParseError{Input: s, Pos: 0, Reason: err.Error()}
+	}
+	if d.W, err = atoiField(matches[3]); err != nil {
+		return Duration{}, &ParseError{Input: s, Pos: 0, Reason: err.Error()}
+	}
+	if d.D, err = atoiField(matches[4]); err != nil {
+		return Duration{}, &ParseError{Input: s, Pos: 0, Reason: err.Error()}
+	}
+
+	thWhole, thFrac, err := parseFractionalField(matches[5])
+	if err != nil {
+		return Duration{}, &ParseError{Input: s, Pos: 0, Reason: err.Error()}
+	}
+	tmWhole, tmFrac, err := parseFractionalField(matches[6])
+	if err != nil {
+		return Duration{}, &ParseError{Input: s, Pos: 0, Reason: err.Error()}
+	}
+	tsWhole, tsFrac, err := parseFractionalField(matches[7])
+	if err != nil {
+		return Duration{}, &ParseError{Input: s, Pos: 0, Reason: err.Error()}
+	}
+
+	// Distribute fractional hours into minutes, then fractional minutes
+	// (including any just carried in) into seconds.
+	tmFrac += thFrac * 60
+	carry := math.Trunc(tmFrac)
+	tmWhole += int(carry)
+	tmFrac -= carry
+
+	tsFrac += tmFrac * 60
+	tsWhole += int(math.Round(tsFrac))
+
+	d.TH, d.TM, d.TS = thWhole, tmWhole, tsWhole
+
+	return d, nil
+}
+
+func atoiField(s string) (int, error) {
+	if s == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid component %q", s)
+	}
+	return n, nil
+}
+
+// parseFractionalField splits a possibly-fractional numeric component into
+// its integer part and its signed fractional remainder.
+func parseFractionalField(s string) (whole int, frac float64, err error) {
+	if s == "" {
+		return 0, 0, nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid component %q", s)
+	}
+	whole = int(math.Trunc(f))
+	frac = f - float64(whole)
+	return whole, frac, nil
+}
@@ -0,0 +1,67 @@
+package duration
+
+import "testing"
+
+func TestCanParseLenientDeviations(t *testing.T) {
+	cases := []struct {
+		from string
+		want Duration
+	}{
+		{"p1y2m", Duration{Y: 1, M: 2}},
+		{"1Y2M3DT4H", Duration{Y: 1, M: 2, D: 3, TH: 4}},
+		{"PT1.5S", Duration{TS: 2}},
+		{"PT1,5S", Duration{TS: 2}},
+	}
+
+	for k, c := range cases {
+		got, err := ParseISO8601Lenient(c.from)
+		if err != nil {
+			t.Fatalf("Case %d: %v", k, err)
+		}
+		if c.want != got {
+			t.Fatalf("Case %d: want=%+v, got=%+v", k, c.want, got)
+		}
+	}
+}
+
+func TestLenientDistributesFractionalHours(t *testing.T) {
+	got, err := ParseISO8601Lenient("PT1.5H")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Duration{TH: 1, TM: 30}
+	if want != got {
+		t.Fatalf("want=%+v, got=%+v", want, got)
+	}
+}
+
+func TestCanRejectBadLenientString(t *testing.T) {
+	cases := []string{
+		"",
+		"not-a-duration",
+	}
+
+	for _, c := range cases {
+		if _, err := ParseISO8601Lenient(c); err == nil {
+			t.Fatalf("%s: expected error, got none", c)
+		}
+	}
+}
+
+func TestParseISO8601ReturnsParseError(t *testing.T) {
+	_, err := ParseISO8601("P1D2F")
+	var parseErr *ParseError
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+	parseErr, ok := err.(*ParseError)
+	if !ok {
+		t.Fatalf("want *ParseError, got %T", err)
+	}
+	if parseErr.Input != "P1D2F" {
+		t.Fatalf("want Input=%q, got=%q", "P1D2F", parseErr.Input)
+	}
+	if parseErr.Pos != 3 {
+		t.Fatalf("want Pos=3, got=%d", parseErr.Pos)
+	}
+}
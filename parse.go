@@ -0,0 +1,77 @@
+package duration
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+var goStyleComponent = regexp.MustCompile(`^(\d+)(w|d|h|m|s)`)
+
+// ParseDuration parses s as either an ISO-8601 period, such as "P1Y2M3DT4H",
+// or a Go-style duration literal, such as "1h30m", extended with "d" (days)
+// and "w" (weeks) units, such as "2w3d". It is the unified entry point for
+// accepting durations from user input where the format is not known ahead
+// of time.
+func ParseDuration(s string) (Duration, error) {
+	if d, err := ParseISO8601(s); err == nil {
+		return d, nil
+	}
+	return parseGoStyleDuration(s)
+}
+
+// parseGoStyleDuration parses a Go time.Duration-like literal extended with
+// "d" and "w" units, e.g. "1h30m" or "2w3d". As with time.ParseDuration, a
+// single leading sign applies to the literal as a whole rather than to its
+// individual components, so "-1h30m" parses as -90 minutes, not -30.
+func parseGoStyleDuration(s string) (Duration, error) {
+	if s == "" {
+		return Duration{}, fmt.Errorf("duration: cannot parse empty string")
+	}
+
+	sign := 1
+	rest := s
+	if rest[0] == '+' || rest[0] == '-' {
+		if rest[0] == '-' {
+			sign = -1
+		}
+		rest = rest[1:]
+	}
+	if rest == "" {
+		return Duration{}, fmt.Errorf("duration: invalid duration %q", s)
+	}
+
+	var d Duration
+	for rest != "" {
+		m := goStyleComponent.FindStringSubmatch(rest)
+		if m == nil {
+			return Duration{}, fmt.Errorf("duration: invalid duration %q", s)
+		}
+
+		n, err := strconv.Atoi(m[1])
+		if err != nil {
+			return Duration{}, fmt.Errorf("duration: invalid duration %q", s)
+		}
+
+		switch m[2] {
+		case "w":
+			d.W += n
+		case "d":
+			d.D += n
+		case "h":
+			d.TH += n
+		case "m":
+			d.TM += n
+		case "s":
+			d.TS += n
+		}
+
+		rest = rest[len(m[0]):]
+	}
+
+	if sign < 0 {
+		d = negate(d)
+	}
+
+	return d, nil
+}
@@ -0,0 +1,44 @@
+package duration
+
+import "testing"
+
+func TestCanParseDuration(t *testing.T) {
+	cases := []struct {
+		from string
+		want Duration
+	}{
+		{"P1Y2M3DT4H", Duration{Y: 1, M: 2, D: 3, TH: 4}},
+		{"1h30m", Duration{TH: 1, TM: 30}},
+		{"2w3d", Duration{W: 2, D: 3}},
+		{"90s", Duration{TS: 90}},
+		{"-1h30m", Duration{TH: -1, TM: -30}},
+		{"+1h30m", Duration{TH: 1, TM: 30}},
+		{"-2w3d", Duration{W: -2, D: -3}},
+	}
+
+	for k, c := range cases {
+		got, err := ParseDuration(c.from)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if c.want != got {
+			t.Fatalf("Case %d: want=%+v, got=%+v", k, c.want, got)
+		}
+	}
+}
+
+func TestCanRejectBadDuration(t *testing.T) {
+	cases := []string{
+		"",
+		"1x",
+		"not-a-duration",
+		"-",
+		"-1h-30m",
+	}
+
+	for _, c := range cases {
+		if _, err := ParseDuration(c); err == nil {
+			t.Fatalf("%s: expected error, got none", c)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package duration
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// recurrenceForm records which of start/duration/end were given explicitly
+// in the parsed string, so that String() can reproduce the original form.
+type recurrenceForm int
+
+const (
+	formStartDuration recurrenceForm = iota
+	formDurationEnd
+	formStartEnd
+)
+
+// Recurrence represents an ISO-8601 repeating interval, such as
+// "R5/2020-01-01T00:00:00Z/P1D" (repeat 5 times, once a day, starting on
+// 2020-01-01) or the unbounded form "R/2020-01-01T00:00:00Z/P1D".
+type Recurrence struct {
+	n     int // number of repetitions; -1 means unbounded (R/...)
+	start time.Time
+	end   time.Time
+	dur   Duration
+	form  recurrenceForm
+}
+
+// ParseRecurrence parses an ISO-8601 repeating interval of the form
+// "Rn/<start>/<duration>", "Rn/<duration>/<end>", "Rn/<start>/<end>", or the
+// unbounded "R/...". Start and end are RFC 3339 timestamps.
+func ParseRecurrence(s string) (Recurrence, error) {
+	parts := strings.Split(s, "/")
+	if len(parts) != 3 || !strings.HasPrefix(parts[0], "R") {
+		return Recurrence{}, fmt.Errorf("duration: invalid recurrence %q", s)
+	}
+
+	n := -1
+	if nStr := strings.TrimPrefix(parts[0], "R"); nStr != "" {
+		parsed, err := strconv.Atoi(nStr)
+		if err != nil || parsed < 0 {
+			return Recurrence{}, fmt.Errorf("duration: invalid recurrence count in %q", s)
+		}
+		n = parsed
+	}
+
+	startIsDuration := strings.HasPrefix(parts[1], "P")
+	endIsDuration := strings.HasPrefix(parts[2], "P")
+
+	switch {
+	case startIsDuration:
+		d, err := ParseISO8601(parts[1])
+		if err != nil {
+			return Recurrence{}, err
+		}
+		end, err := time.Parse(time.RFC3339, parts[2])
+		if err != nil {
+			return Recurrence{}, fmt.Errorf("duration: invalid recurrence end %q", parts[2])
+		}
+		if n < 0 {
+			return Recurrence{}, fmt.Errorf("duration: unbounded recurrence %q requires an explicit start, not an end", s)
+		}
+		return Recurrence{n: n, start: d.Neg().Mul(n).Shift(end), end: end, dur: d, form: formDurationEnd}, nil
+
+	case endIsDuration:
+		start, err := time.Parse(time.RFC3339, parts[1])
+		if err != nil {
+			return Recurrence{}, fmt.Errorf("duration: invalid recurrence start %q", parts[1])
+		}
+		d, err := ParseISO8601(parts[2])
+		if err != nil {
+			return Recurrence{}, err
+		}
+		return Recurrence{n: n, start: start, dur: d, form: formStartDuration}, nil
+
+	default:
+		start, err := time.Parse(time.RFC3339, parts[1])
+		if err != nil {
+			return Recurrence{}, fmt.Errorf("duration: invalid recurrence start %q", parts[1])
+		}
+		end, err := time.Parse(time.RFC3339, parts[2])
+		if err != nil {
+			return Recurrence{}, fmt.Errorf("duration: invalid recurrence end %q", parts[2])
+		}
+		return Recurrence{n: n, start: start, end: end, dur: Between(start, end), form: formStartEnd}, nil
+	}
+}
+
+// String returns the ISO-8601 representation of the recurrence, in the same
+// form (start/duration, duration/end, or start/end) it was parsed from.
+func (r Recurrence) String() string {
+	prefix := "R"
+	if r.n >= 0 {
+		prefix += strconv.Itoa(r.n)
+	}
+
+	switch r.form {
+	case formDurationEnd:
+		return prefix + "/" + r.dur.String() + "/" + r.end.Format(time.RFC3339)
+	case formStartEnd:
+		return prefix + "/" + r.start.Format(time.RFC3339) + "/" + r.end.Format(time.RFC3339)
+	default:
+		return prefix + "/" + r.start.Format(time.RFC3339) + "/" + r.dur.String()
+	}
+}
+
+// MarshalJSON implements json.Marshaler, encoding the recurrence as its
+// ISO-8601 string representation.
+func (r Recurrence) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(r.String())), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding an ISO-8601 repeating
+// interval string.
+func (r *Recurrence) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return fmt.Errorf("duration: invalid JSON recurrence %q", b)
+	}
+
+	parsed, err := ParseRecurrence(s)
+	if err != nil {
+		return err
+	}
+
+	*r = parsed
+	return nil
+}
+
+// count returns the total number of occurrences, or -1 if unbounded.
+func (r Recurrence) count() int {
+	if r.n < 0 {
+		return -1
+	}
+	return r.n + 1
+}
+
+// Next returns the first occurrence of the recurrence strictly after t, and
+// true if one exists. It returns false once the recurrence is exhausted,
+// either because its repetition count or its end bound has been reached.
+func (r Recurrence) Next(t time.Time) (time.Time, bool) {
+	if r.dur.IsZero() {
+		if r.start.After(t) {
+			return r.start, true
+		}
+		return time.Time{}, false
+	}
+
+	total := r.count()
+	occ := r.start
+	for k := 0; total < 0 || k < total; k++ {
+		if k > 0 {
+			occ = r.dur.Shift(occ)
+		}
+		if !occ.After(t) {
+			continue
+		}
+		if !r.end.IsZero() && occ.After(r.end) {
+			return time.Time{}, false
+		}
+		return occ, true
+	}
+
+	return time.Time{}, false
+}
+
+// All returns up to limit occurrences of the recurrence, in order,
+// respecting its repetition count and end bound. A non-positive limit
+// returns every bounded occurrence; it returns no occurrences at all for a
+// recurrence that is unbounded in both repetition count and end time, since
+// there would be no way to stop.
+func (r Recurrence) All(limit int) []time.Time {
+	total := r.count()
+	if limit <= 0 && total < 0 && r.end.IsZero() {
+		return nil
+	}
+
+	var out []time.Time
+	occ := r.start
+	for k := 0; total < 0 || k < total; k++ {
+		if limit > 0 && len(out) >= limit {
+			break
+		}
+		if k > 0 {
+			occ = r.dur.Shift(occ)
+		}
+
+		if !r.end.IsZero() && occ.After(r.end) {
+			break
+		}
+
+		out = append(out, occ)
+
+		if r.dur.IsZero() {
+			break
+		}
+	}
+
+	return out
+}
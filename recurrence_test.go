@@ -0,0 +1,148 @@
+package duration
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestCanParseRecurrenceStartDuration(t *testing.T) {
+	got, err := ParseRecurrence("R5/2020-01-01T00:00:00Z/P1D")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	if !got.start.Equal(want) {
+		t.Fatalf("want start=%s, got=%s", want, got.start)
+	}
+	if got.n != 5 {
+		t.Fatalf("want n=5, got=%d", got.n)
+	}
+}
+
+func TestCanParseUnboundedRecurrence(t *testing.T) {
+	got, err := ParseRecurrence("R/2020-01-01T00:00:00Z/P1D")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.n != -1 {
+		t.Fatalf("want n=-1, got=%d", got.n)
+	}
+}
+
+func TestCanRejectBadRecurrence(t *testing.T) {
+	cases := []string{
+		"",
+		"2020-01-01T00:00:00Z/P1D",
+		"R5/not-a-time/P1D",
+		"R/P1D/2020-01-01T00:00:00Z",
+	}
+
+	for _, c := range cases {
+		if _, err := ParseRecurrence(c); err == nil {
+			t.Fatalf("%s: expected error, got none", c)
+		}
+	}
+}
+
+func TestRecurrenceRoundTripsThroughString(t *testing.T) {
+	cases := []string{
+		"R5/2020-01-01T00:00:00Z/P1D",
+		"R5/P1D/2020-01-10T00:00:00Z",
+		"R5/2020-01-01T00:00:00Z/2020-01-10T00:00:00Z",
+		"R/2020-01-01T00:00:00Z/P1D",
+	}
+
+	for _, c := range cases {
+		sut, err := ParseRecurrence(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := sut.String(); got != c {
+			t.Fatalf("want=%s, got=%s", c, got)
+		}
+	}
+}
+
+func TestRecurrenceNextAndAll(t *testing.T) {
+	sut, err := ParseRecurrence("R2/2020-01-01T00:00:00Z/P1D")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []time.Time{
+		time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, time.January, 2, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, time.January, 3, 0, 0, 0, 0, time.UTC),
+	}
+
+	got := sut.All(0)
+	if len(got) != len(want) {
+		t.Fatalf("want %d occurrences, got %d: %v", len(want), len(got), got)
+	}
+	for k := range want {
+		if !want[k].Equal(got[k]) {
+			t.Fatalf("occurrence %d: want=%s, got=%s", k, want[k], got[k])
+		}
+	}
+
+	next, ok := sut.Next(want[0])
+	if !ok || !next.Equal(want[1]) {
+		t.Fatalf("want next=%s, got=%s (ok=%v)", want[1], next, ok)
+	}
+
+	if _, ok := sut.Next(want[2]); ok {
+		t.Fatal("expected recurrence to be exhausted")
+	}
+}
+
+func TestRecurrenceFoldsMonthlyOccurrencesSequentially(t *testing.T) {
+	sut, err := ParseRecurrence("R2/2021-01-31T00:00:00Z/P1M")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Each occurrence shifts by one month from the last, not by k months
+	// from the start: Jan 31 -> Mar 3 (Feb has no 31st) -> Apr 3, rather
+	// than Jan 31 -> Mar 3 -> Mar 31 if "2 months" were applied in one jump.
+	want := []time.Time{
+		time.Date(2021, time.January, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, time.March, 3, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, time.April, 3, 0, 0, 0, 0, time.UTC),
+	}
+
+	got := sut.All(0)
+	if len(got) != len(want) {
+		t.Fatalf("want %d occurrences, got %d: %v", len(want), len(got), got)
+	}
+	for k := range want {
+		if !want[k].Equal(got[k]) {
+			t.Fatalf("occurrence %d: want=%s, got=%s", k, want[k], got[k])
+		}
+	}
+}
+
+func TestCanMarshalAndUnmarshalRecurrenceJSON(t *testing.T) {
+	s := "R5/2020-01-01T00:00:00Z/P1D"
+	sut, err := ParseRecurrence(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := json.Marshal(sut)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `"` + s + `"`; string(b) != want {
+		t.Fatalf("want=%s, got=%s", want, string(b))
+	}
+
+	var got Recurrence
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.String() != s {
+		t.Fatalf("want=%s, got=%s", s, got.String())
+	}
+}
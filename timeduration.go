@@ -0,0 +1,93 @@
+package duration
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// now returns the current time and is a package-level hook so that tests can
+// override it to make TimeDuration resolution deterministic.
+var now = time.Now
+
+// TimeDuration represents either an absolute point in time or a Duration
+// relative to "now", such as "expires at 2030-01-01T00:00:00Z" or "expires
+// in P1Y". The relative form is resolved to a concrete time.Time on each
+// call to Time().
+type TimeDuration struct {
+	t        time.Time
+	d        Duration
+	relative bool
+}
+
+// NewTimeDuration returns a TimeDuration representing the absolute time t.
+func NewTimeDuration(t time.Time) TimeDuration {
+	return TimeDuration{t: t}
+}
+
+// NewTimeDurationFromDuration returns a TimeDuration relative to now, as
+// resolved by a call to Time().
+func NewTimeDurationFromDuration(d Duration) TimeDuration {
+	return TimeDuration{d: d, relative: true}
+}
+
+// ParseTimeDuration parses s as either an RFC 3339 timestamp or a duration
+// accepted by ParseDuration.
+func ParseTimeDuration(s string) (TimeDuration, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return NewTimeDuration(t), nil
+	}
+
+	d, err := ParseDuration(s)
+	if err != nil {
+		return TimeDuration{}, fmt.Errorf("duration: invalid time/duration %q", s)
+	}
+
+	return NewTimeDurationFromDuration(d), nil
+}
+
+// Time returns the concrete time.Time represented by t, resolving a
+// relative Duration against now().
+func (t TimeDuration) Time() time.Time {
+	if t.relative {
+		return t.d.Shift(now())
+	}
+	return t.t
+}
+
+// IsZero reports whether t represents neither an absolute time nor a
+// relative Duration.
+func (t TimeDuration) IsZero() bool {
+	return !t.relative && t.t.IsZero()
+}
+
+// MarshalJSON implements json.Marshaler. Absolute times are encoded as RFC
+// 3339 timestamps; relative durations are encoded as ISO-8601 periods.
+func (t TimeDuration) MarshalJSON() ([]byte, error) {
+	if t.relative {
+		return json.Marshal(t.d.String())
+	}
+	return json.Marshal(t.t)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It first tries to decode the
+// value as an RFC 3339 timestamp, then falls back to ParseDuration.
+func (t *TimeDuration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("duration: invalid JSON time/duration %q", b)
+	}
+
+	if parsed, err := time.Parse(time.RFC3339, s); err == nil {
+		*t = NewTimeDuration(parsed)
+		return nil
+	}
+
+	d, err := ParseDuration(s)
+	if err != nil {
+		return err
+	}
+
+	*t = NewTimeDurationFromDuration(d)
+	return nil
+}
@@ -0,0 +1,73 @@
+package duration
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestCanResolveAbsoluteTimeDuration(t *testing.T) {
+	want := makeTime(t, "Jan 1, 2018 at 00:00:00")
+	sut := NewTimeDuration(want)
+
+	if got := sut.Time(); !want.Equal(got) {
+		t.Fatalf("want=%s, got=%s", want, got)
+	}
+}
+
+func TestCanResolveRelativeTimeDuration(t *testing.T) {
+	fixed := makeTime(t, "Jan 1, 2018 at 00:00:00")
+	old := now
+	now = func() time.Time { return fixed }
+	defer func() { now = old }()
+
+	sut := NewTimeDurationFromDuration(Duration{D: 1})
+
+	want := makeTime(t, "Jan 2, 2018 at 00:00:00")
+	if got := sut.Time(); !want.Equal(got) {
+		t.Fatalf("want=%s, got=%s", want, got)
+	}
+}
+
+func TestCanUnmarshalTimeDurationJSON(t *testing.T) {
+	cases := []struct {
+		json string
+		want time.Time
+	}{
+		{`"2018-01-01T00:00:00Z"`, makeTime(t, "Jan 1, 2018 at 00:00:00").UTC()},
+	}
+
+	for k, c := range cases {
+		var got TimeDuration
+		if err := json.Unmarshal([]byte(c.json), &got); err != nil {
+			t.Fatal(err)
+		}
+		if !c.want.Equal(got.Time()) {
+			t.Fatalf("Case %d: want=%s, got=%s", k, c.want, got.Time())
+		}
+	}
+}
+
+func TestCanUnmarshalRelativeTimeDurationJSON(t *testing.T) {
+	fixed := makeTime(t, "Jan 1, 2018 at 00:00:00")
+	old := now
+	now = func() time.Time { return fixed }
+	defer func() { now = old }()
+
+	var got TimeDuration
+	if err := json.Unmarshal([]byte(`"P1D"`), &got); err != nil {
+		t.Fatal(err)
+	}
+
+	want := makeTime(t, "Jan 2, 2018 at 00:00:00")
+	if !want.Equal(got.Time()) {
+		t.Fatalf("want=%s, got=%s", want, got.Time())
+	}
+}
+
+func TestCanRejectBadTimeDurationJSON(t *testing.T) {
+	var got TimeDuration
+	if err := json.Unmarshal([]byte(`"not-a-time-or-duration"`), &got); err == nil {
+		t.Fatal("expected error, got none")
+	}
+}